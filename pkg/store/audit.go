@@ -0,0 +1,77 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const auditLogFileName = "audit.log"
+
+// AuditLogMaxSize is the size, in bytes, at which appendAuditLog rotates
+// <instDir>/audit.log to audit.log.1 before appending the next record.
+// Set to 0 to disable rotation.
+var AuditLogMaxSize int64 = 10 * 1024 * 1024 // 10 MiB
+
+// auditRecord is one JSON-lines entry in <instDir>/audit.log, written once
+// per Inspect call so users can reconstruct why an instance was marked
+// Broken after the fact.
+type auditRecord struct {
+	Time     time.Time     `json:"time"`
+	Instance string        `json:"instance"`
+	Status   Status        `json:"status"`
+	Errors   []string      `json:"errors,omitempty"`
+	Took     time.Duration `json:"took"`
+}
+
+// appendAuditLog best-effort appends rec to instDir's audit log, rotating
+// it first if it has reached AuditLogMaxSize. Failures are logged, not
+// returned, since auditing must never cause Inspect itself to fail.
+func appendAuditLog(instDir string, rec auditRecord) {
+	path := filepath.Join(instDir, auditLogFileName)
+	if err := rotateAuditLog(path); err != nil {
+		logger.Warn("failed to rotate audit log", "path", path, "error", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		logger.Warn("failed to open audit log", "path", path, "error", err)
+		return
+	}
+	defer f.Close()
+	if err := json.NewEncoder(f).Encode(rec); err != nil {
+		logger.Warn("failed to write audit log", "path", path, "error", err)
+	}
+}
+
+// errorStrings renders errs for the audit log, since the JSON encoding of
+// the error interface itself carries no useful information.
+func errorStrings(errs []error) []string {
+	if len(errs) == 0 {
+		return nil
+	}
+	out := make([]string, len(errs))
+	for i, err := range errs {
+		out[i] = err.Error()
+	}
+	return out
+}
+
+// rotateAuditLog renames path to path+".1" once its size has reached
+// AuditLogMaxSize, overwriting any previous rotation.
+func rotateAuditLog(path string) error {
+	if AuditLogMaxSize <= 0 {
+		return nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if fi.Size() < AuditLogMaxSize {
+		return nil
+	}
+	return os.Rename(path, path+".1")
+}
@@ -64,6 +64,15 @@ func (inst *Instance) LoadYAML() (*limayaml.LimaYAML, error) {
 // Inspect returns err only when the instance does not exist (os.ErrNotExist).
 // Other errors are returned as *Instance.Errors
 func Inspect(instName string) (*Instance, error) {
+	return inspect(instName, true)
+}
+
+// inspect is the shared implementation behind Inspect. audit controls
+// whether the call appends an audit.log record; Watch polls on a 1s
+// ticker and calls inspect(instName, false) so it doesn't write an audit
+// record on every poll.
+func inspect(instName string, audit bool) (*Instance, error) {
+	start := time.Now()
 	inst := &Instance{
 		Name:   instName,
 		Status: StatusUnknown,
@@ -75,6 +84,17 @@ func Inspect(instName string) (*Instance, error) {
 	}
 	// Make sure inst.Dir is set, even when YAML validation fails
 	inst.Dir = instDir
+	if audit {
+		defer func() {
+			appendAuditLog(instDir, auditRecord{
+				Time:     time.Now(),
+				Instance: inst.Name,
+				Status:   inst.Status,
+				Errors:   errorStrings(inst.Errors),
+				Took:     time.Since(start),
+			})
+		}()
+	}
 	yamlPath := filepath.Join(instDir, filenames.LimaYAML)
 	y, err := LoadYAMLByFilePath(yamlPath)
 	if err != nil {
@@ -82,6 +102,7 @@ func Inspect(instName string) (*Instance, error) {
 			return nil, err
 		}
 		inst.Errors = append(inst.Errors, err)
+		logger.Error("failed to load instance YAML", "instance", instName, "error", err)
 		return inst, nil
 	}
 	inst.Config = y
@@ -106,6 +127,7 @@ func Inspect(instName string) (*Instance, error) {
 	if err != nil {
 		inst.Status = StatusBroken
 		inst.Errors = append(inst.Errors, err)
+		logger.Warn("failed to read host agent PID file", "instance", instName, "error", err)
 	}
 
 	if inst.HostAgentPID != 0 {
@@ -114,13 +136,16 @@ func Inspect(instName string) (*Instance, error) {
 		if err != nil {
 			inst.Status = StatusBroken
 			inst.Errors = append(inst.Errors, fmt.Errorf("failed to connect to %q: %w", haSock, err))
+			logger.Warn("failed to connect to host agent", "instance", instName, "socket", haSock, "error", err)
 		} else {
 			ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
 			defer cancel()
+			infoStart := time.Now()
 			info, err := haClient.Info(ctx)
 			if err != nil {
 				inst.Status = StatusBroken
 				inst.Errors = append(inst.Errors, fmt.Errorf("failed to get Info from %q: %w", haSock, err))
+				logger.Warn("host agent Info call failed", "instance", instName, "took", time.Since(infoStart), "error", err)
 			} else {
 				inst.SSHLocalPort = info.SSHLocalPort
 			}
@@ -131,6 +156,7 @@ func Inspect(instName string) (*Instance, error) {
 	if err != nil {
 		inst.Status = StatusBroken
 		inst.Errors = append(inst.Errors, err)
+		logger.Warn("failed to read driver PID file", "instance", instName, "error", err)
 	}
 
 	if inst.Status == StatusUnknown {
@@ -141,9 +167,11 @@ func Inspect(instName string) (*Instance, error) {
 		} else if inst.HostAgentPID > 0 && inst.DriverPID == 0 {
 			inst.Errors = append(inst.Errors, errors.New("host agent is running but driver is not"))
 			inst.Status = StatusBroken
+			logger.Warn("host agent running without driver", "instance", instName, "hostAgentPID", inst.HostAgentPID)
 		} else {
 			inst.Errors = append(inst.Errors, fmt.Errorf("%s driver is running but host agent is not", inst.VMType))
 			inst.Status = StatusBroken
+			logger.Warn("driver running without host agent", "instance", instName, "driverPID", inst.DriverPID)
 		}
 	}
 
@@ -192,6 +220,7 @@ func ReadPIDFile(path string) (int, error) {
 	if err != nil {
 		if errors.Is(err, os.ErrProcessDone) {
 			_ = os.Remove(path)
+			logger.Debug("removed stale PID file", "path", path, "pid", pid, "reason", "process already exited")
 			return 0, nil
 		}
 		// We may not have permission to send the signal (e.g. to network daemon running as root).
@@ -235,6 +264,7 @@ func AddGlobalFields(inst *Instance) (FormatData, error) {
 // PrintInstances prints instances in a requested format to a given io.Writer.
 // Supported formats are "json", "yaml", "table", or a go template
 func PrintInstances(w io.Writer, instances []*Instance, format string) error {
+	logger.Debug("printing instances", "count", len(instances), "format", format)
 	switch format {
 	case "json":
 		format = "{{json .}}"
@@ -242,7 +272,8 @@ func PrintInstances(w io.Writer, instances []*Instance, format string) error {
 		format = "{{yaml .}}"
 	case "table":
 		w := tabwriter.NewWriter(w, 4, 8, 4, ' ', 0)
-		fmt.Fprintln(w, "NAME\tSTATUS\tSSH\tVMTYPE\tARCH\tCPUS\tMEMORY\tDISK\tDIR")
+		header := "NAME\tSTATUS\tSSH\tVMTYPE\tARCH\tCPUS\tMEMORY\tDISK\tDIR"
+		fmt.Fprintln(w, header)
 
 		u, err := user.Current()
 		if err != nil {
@@ -255,7 +286,7 @@ func PrintInstances(w io.Writer, instances []*Instance, format string) error {
 			if strings.HasPrefix(dir, homeDir) {
 				dir = strings.Replace(dir, homeDir, "~", 1)
 			}
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s",
 				instance.Name,
 				instance.Status,
 				fmt.Sprintf("127.0.0.1:%d", instance.SSHLocalPort),
@@ -266,6 +297,7 @@ func PrintInstances(w io.Writer, instances []*Instance, format string) error {
 				units.BytesSize(float64(instance.Disk)),
 				dir,
 			)
+			fmt.Fprintln(w)
 		}
 		return w.Flush()
 	default:
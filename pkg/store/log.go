@@ -0,0 +1,35 @@
+package store
+
+// Logger is a minimal hclog-style structured logger, scoped to this
+// package, for tracing inspection decisions (which PID files were stale,
+// which instances flipped to StatusBroken and why) without forcing a
+// particular logging library on callers.
+//
+// kv is a sequence of alternating key/value pairs, e.g.
+// logger.Warn("pid file stale", "instance", name, "pid", pid).
+type Logger interface {
+	Debug(msg string, kv ...any)
+	Info(msg string, kv ...any)
+	Warn(msg string, kv ...any)
+	Error(msg string, kv ...any)
+}
+
+// noopLogger is the default Logger: it discards everything so that callers
+// who never call SetLogger see no behavior change.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+func (noopLogger) Info(string, ...any)  {}
+func (noopLogger) Warn(string, ...any)  {}
+func (noopLogger) Error(string, ...any) {}
+
+var logger Logger = noopLogger{}
+
+// SetLogger installs l as the package-wide logger used by Inspect,
+// ReadPIDFile, and PrintInstances. Passing nil restores the noop default.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = noopLogger{}
+	}
+	logger = l
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchPollInterval bounds how long Watch can take to notice a status
+// change that isn't visible as a filesystem event, e.g. the host agent or
+// driver process dying without removing its PID file in time.
+const watchPollInterval = 1 * time.Second
+
+// Watch emits a new *Instance snapshot on out whenever instName's Status,
+// SSHLocalPort, HostAgentPID, or DriverPID changes, until ctx is done or an
+// unrecoverable error occurs. It combines fsnotify on the instance directory
+// (PID files appearing/disappearing) with periodic liveness polling, since
+// a dead host agent does not always get to clean up its own PID file.
+func Watch(ctx context.Context, instName string, out chan<- *Instance) error {
+	instDir, err := InstanceDir(instName)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	if err := watcher.Add(instDir); err != nil {
+		return err
+	}
+
+	var last *Instance
+	emit := func() error {
+		// Use the unaudited core: Watch polls every watchPollInterval, and
+		// an audit.log record per poll per instance would otherwise grow
+		// unbounded for the lifetime of a `limactl list --watch`.
+		inst, err := inspect(instName, false)
+		if err != nil {
+			return err
+		}
+		if last == nil || changed(last, inst) {
+			last = inst
+			select {
+			case out <- inst:
+			case <-ctx.Done():
+				// Context cancellation is a clean shutdown, not a failure;
+				// the caller already treats <-ctx.Done() in its own select
+				// below as returning nil.
+				return nil
+			}
+		}
+		return nil
+	}
+
+	if err := emit(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.Errors:
+			return err
+		case <-watcher.Events:
+			if err := emit(); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := emit(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// WatchAll runs Watch for every instance under instNames concurrently,
+// fanning all snapshots into the single out channel. It returns once every
+// per-instance watch has stopped; each stops when ctx is done or when it
+// hits its own error, and one watch's error does not stop the others.
+// The first error seen is returned.
+func WatchAll(ctx context.Context, instNames []string, out chan<- *Instance) error {
+	errs := make(chan error, len(instNames))
+	for _, instName := range instNames {
+		instName := instName
+		go func() {
+			errs <- Watch(ctx, instName, out)
+		}()
+	}
+	var firstErr error
+	for range instNames {
+		if err := <-errs; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// changed reports whether any of the fields that Watch cares about differ
+// between two snapshots of the same instance.
+func changed(a, b *Instance) bool {
+	return a.Status != b.Status ||
+		a.SSHLocalPort != b.SSHLocalPort ||
+		a.HostAgentPID != b.HostAgentPID ||
+		a.DriverPID != b.DriverPID
+}
@@ -0,0 +1,24 @@
+package store
+
+import "testing"
+
+func TestChanged(t *testing.T) {
+	base := &Instance{Status: StatusStopped, SSHLocalPort: 1, HostAgentPID: 0, DriverPID: 0}
+
+	same := *base
+	if changed(base, &same) {
+		t.Fatal("identical snapshots should not be reported as changed")
+	}
+
+	cases := []*Instance{
+		{Status: StatusRunning, SSHLocalPort: 1, HostAgentPID: 0, DriverPID: 0},
+		{Status: StatusStopped, SSHLocalPort: 2, HostAgentPID: 0, DriverPID: 0},
+		{Status: StatusStopped, SSHLocalPort: 1, HostAgentPID: 100, DriverPID: 0},
+		{Status: StatusStopped, SSHLocalPort: 1, HostAgentPID: 0, DriverPID: 200},
+	}
+	for i, c := range cases {
+		if !changed(base, c) {
+			t.Errorf("case %d: expected change to be detected", i)
+		}
+	}
+}